@@ -0,0 +1,158 @@
+package minikv
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrKeyNotFound is returned by the Increment/Decrement family when the
+	// key was never set.
+	ErrKeyNotFound = errors.New("key args not exist")
+	// ErrKeyExpired is returned by the Increment/Decrement family when the
+	// key was set but its expiration has already passed.
+	ErrKeyExpired = errors.New("key args expired")
+	// ErrNotNumeric is returned by the Increment/Decrement family when the
+	// stored value isn't a numeric type that can be added to.
+	ErrNotNumeric = errors.New("stored value is not numeric")
+)
+
+// Increment adds n to the int64-typed value stored at key, in place,
+// preserving its current Expiration. It returns an error if the key is
+// missing/expired or its stored value is not numeric.
+func (kv *KV[K, V]) Increment(key K, n int64) error {
+	_, err := kv.addNumeric(key, n)
+	return err
+}
+
+// Decrement subtracts n from the numeric value stored at key, in place,
+// preserving its current Expiration.
+func (kv *KV[K, V]) Decrement(key K, n int64) error {
+	_, err := kv.addNumeric(key, -n)
+	return err
+}
+
+// IncrementFloat adds n to the float-typed value stored at key, in place,
+// preserving its current Expiration.
+func (kv *KV[K, V]) IncrementFloat(key K, n float64) error {
+	_, err := kv.addNumericFloat(key, n)
+	return err
+}
+
+// IncrementInt adds n to an int value stored at key and returns the new
+// value.
+func (kv *KV[K, V]) IncrementInt(key K, n int) (int, error) {
+	v, err := kv.addNumeric(key, int64(n))
+	return int(v), err
+}
+
+// IncrementInt64 adds n to an int64 value stored at key and returns the new
+// value.
+func (kv *KV[K, V]) IncrementInt64(key K, n int64) (int64, error) {
+	return kv.addNumeric(key, n)
+}
+
+// IncrementUint64 adds n to a uint64 value stored at key and returns the
+// new value.
+func (kv *KV[K, V]) IncrementUint64(key K, n uint64) (uint64, error) {
+	v, err := kv.addNumeric(key, int64(n))
+	return uint64(v), err
+}
+
+// IncrementFloat64 adds n to a float64 value stored at key and returns the
+// new value.
+func (kv *KV[K, V]) IncrementFloat64(key K, n float64) (float64, error) {
+	return kv.addNumericFloat(key, n)
+}
+
+// addNumeric does the locked load/modify/store for the integer family of
+// Increment/Decrement methods.
+func (kv *KV[K, V]) addNumeric(key K, n int64) (int64, error) {
+	lock := kv.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	obj, ok := kv.items.Load(key)
+	if !ok {
+		return 0, ErrKeyNotFound
+	}
+
+	item := obj.(Item[V])
+	if !notExpired(item.Expiration, time.Now().UnixNano()) {
+		return 0, ErrKeyExpired
+	}
+
+	var result int64
+	switch cur := any(item.Object).(type) {
+	case int:
+		result = int64(cur) + n
+		item.Object = any(int(result)).(V)
+	case int8:
+		result = int64(cur) + n
+		item.Object = any(int8(result)).(V)
+	case int16:
+		result = int64(cur) + n
+		item.Object = any(int16(result)).(V)
+	case int32:
+		result = int64(cur) + n
+		item.Object = any(int32(result)).(V)
+	case int64:
+		result = cur + n
+		item.Object = any(result).(V)
+	case uint:
+		result = int64(cur) + n
+		item.Object = any(uint(result)).(V)
+	case uint8:
+		result = int64(cur) + n
+		item.Object = any(uint8(result)).(V)
+	case uint16:
+		result = int64(cur) + n
+		item.Object = any(uint16(result)).(V)
+	case uint32:
+		result = int64(cur) + n
+		item.Object = any(uint32(result)).(V)
+	case uint64:
+		result = int64(cur) + n
+		item.Object = any(uint64(result)).(V)
+	default:
+		return 0, ErrNotNumeric
+	}
+
+	kv.items.Store(key, item)
+
+	return result, nil
+}
+
+// addNumericFloat does the locked load/modify/store for the float family of
+// Increment methods.
+func (kv *KV[K, V]) addNumericFloat(key K, n float64) (float64, error) {
+	lock := kv.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	obj, ok := kv.items.Load(key)
+	if !ok {
+		return 0, ErrKeyNotFound
+	}
+
+	item := obj.(Item[V])
+	if !notExpired(item.Expiration, time.Now().UnixNano()) {
+		return 0, ErrKeyExpired
+	}
+
+	var result float64
+	switch cur := any(item.Object).(type) {
+	case float32:
+		result = float64(cur) + n
+		item.Object = any(float32(result)).(V)
+	case float64:
+		result = cur + n
+		item.Object = any(result).(V)
+	default:
+		return 0, ErrNotNumeric
+	}
+
+	kv.items.Store(key, item)
+
+	return result, nil
+}
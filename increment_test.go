@@ -0,0 +1,123 @@
+package minikv
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIncrementDecrement(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+	kv.Set("count", int64(10), time.Hour)
+
+	if err := kv.Increment("count", 5); err != nil {
+		t.Fatalf("Increment: %v", err)
+	}
+	if v, _ := kv.Get("count"); v != int64(15) {
+		t.Errorf("expected 15, got %v", v)
+	}
+
+	if err := kv.Decrement("count", 3); err != nil {
+		t.Fatalf("Decrement: %v", err)
+	}
+	if v, _ := kv.Get("count"); v != int64(12) {
+		t.Errorf("expected 12, got %v", v)
+	}
+}
+
+func TestIncrementPreservesTTL(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+	kv.Set("count", int64(1), 200*time.Millisecond)
+
+	before, ok := kv.items.Load("count")
+	if !ok {
+		t.Fatal("count should exist")
+	}
+	expBefore := before.(Item[interface{}]).Expiration
+
+	if err := kv.Increment("count", 1); err != nil {
+		t.Fatalf("Increment: %v", err)
+	}
+
+	after, _ := kv.items.Load("count")
+	expAfter := after.(Item[interface{}]).Expiration
+
+	if expBefore != expAfter {
+		t.Errorf("Increment should not change Expiration: before=%d after=%d", expBefore, expAfter)
+	}
+}
+
+func TestIncrementFloat(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+	kv.Set("ratio", 1.5, time.Hour)
+
+	if err := kv.IncrementFloat("ratio", 0.25); err != nil {
+		t.Fatalf("IncrementFloat: %v", err)
+	}
+	if v, _ := kv.Get("ratio"); v != 1.75 {
+		t.Errorf("expected 1.75, got %v", v)
+	}
+}
+
+func TestIncrementTypedVariants(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+
+	kv.Set("i", 1, time.Hour)
+	vi, err := kv.IncrementInt("i", 2)
+	if err != nil || vi != 3 {
+		t.Errorf("IncrementInt: got %d, err %v", vi, err)
+	}
+
+	kv.Set("i64", int64(1), time.Hour)
+	vi64, err := kv.IncrementInt64("i64", 2)
+	if err != nil || vi64 != 3 {
+		t.Errorf("IncrementInt64: got %d, err %v", vi64, err)
+	}
+
+	kv.Set("u64", uint64(1), time.Hour)
+	vu64, err := kv.IncrementUint64("u64", 2)
+	if err != nil || vu64 != 3 {
+		t.Errorf("IncrementUint64: got %d, err %v", vu64, err)
+	}
+
+	kv.Set("f64", 1.0, time.Hour)
+	vf64, err := kv.IncrementFloat64("f64", 0.5)
+	if err != nil || vf64 != 1.5 {
+		t.Errorf("IncrementFloat64: got %v, err %v", vf64, err)
+	}
+}
+
+func TestIncrementErrors(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+
+	if err := kv.Increment("missing", 1); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("expected ErrKeyNotFound incrementing a missing key, got %v", err)
+	}
+
+	kv.Set("str", "not a number", time.Hour)
+	if err := kv.Increment("str", 1); !errors.Is(err, ErrNotNumeric) {
+		t.Errorf("expected ErrNotNumeric incrementing a non-numeric value, got %v", err)
+	}
+
+	// Store an already-expired item directly, bypassing Set/kv.exp, so the
+	// janitor's own sweep can't race it away before Increment sees it.
+	kv.items.Store("already-expired", Item[interface{}]{
+		Object:     int64(1),
+		Expiration: time.Now().Add(-time.Minute).UnixNano(),
+	})
+	if err := kv.Increment("already-expired", 1); !errors.Is(err, ErrKeyExpired) {
+		t.Errorf("expected ErrKeyExpired incrementing an expired key, got %v", err)
+	}
+}
+
+func TestIncrementNoExpiration(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+	kv.Set("count", int64(1), NoExpiration)
+
+	if err := kv.Increment("count", 4); err != nil {
+		t.Fatalf("Increment on a NoExpiration key should succeed: %v", err)
+	}
+	if v, _ := kv.Get("count"); v != int64(5) {
+		t.Errorf("expected 5, got %v", v)
+	}
+}
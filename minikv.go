@@ -2,6 +2,8 @@ package minikv
 
 import (
 	"errors"
+	"fmt"
+	"hash/fnv"
 	"sync"
 	"time"
 )
@@ -15,72 +17,108 @@ const (
 	DefaultExpiration time.Duration = 0
 )
 
-type meta struct {
-	Key        string
+type meta[K comparable] struct {
+	Key        K
 	Expiration int64
 }
 
-type updateMeta struct {
-	meta
+type updateMeta[K comparable] struct {
+	meta[K]
 	PriorExpiration int64
 }
 
-type Item struct {
-	Key        string
-	Object     interface{}
+type Item[V any] struct {
+	Object     V
 	Expiration int64
 }
 
-func (item Item) Expired() bool {
+func (item Item[V]) Expired() bool {
+	if item.Expiration == 0 {
+		// Expiration == 0 means NoExpiration was used when the item was Set;
+		// it never expires.
+		return false
+	}
 	now := time.Now().UnixNano()
 	return now > item.Expiration
 }
 
-type KV struct {
+// notExpired reports whether an item with the given Expiration is still
+// live at now. Expiration == 0 is the NoExpiration sentinel and is always
+// live; anything else is live only while it's in the future.
+func notExpired(expiration, now int64) bool {
+	return expiration == 0 || expiration > now
+}
+
+type KV[K comparable, V any] struct {
 	defaultExpiration time.Duration
 	cleanupInterval   time.Duration
 	items             sync.Map
 	mu                sync.RWMutex
-	onDeleted         func(string, interface{})
-	onEvicted         func(string, interface{})
+	onDeleted         func(K, V)
+	onEvicted         func(K, V)
+	onEvictedBulk     func([]KeyAndValue[K, V])
 
-	metaAdd    chan meta
-	metaUpdate chan updateMeta
-	metaDelete chan meta
+	metaAdd    chan meta[K]
+	metaUpdate chan updateMeta[K]
+	metaDelete chan meta[K]
 
-	exp map[int64]map[string]*struct{}
-}
-
-func New(defaultExpiration, cleanupInterval time.Duration) *KV {
+	exp map[int64]map[K]*struct{}
 
-	new := KV{
-		defaultExpiration: defaultExpiration,
-		cleanupInterval:   cleanupInterval,
-		//TODO: configure capacities
-		metaAdd:    make(chan meta, 10),
-		metaUpdate: make(chan updateMeta, 10),
-		metaDelete: make(chan meta, 10),
-		exp:        make(map[int64]map[string]*struct{}),
-	}
+	// keyLocks stripes per-key locking for in-place updates (Increment &
+	// friends) so concurrent operations on the same key serialize without
+	// taking kv.mu, which guards callback registration, not item data.
+	keyLocks [256]sync.Mutex
+}
 
-	go new.runJanitor()
+func (kv *KV[K, V]) keyLock(key K) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(fmt.Sprint(key)))
+	return &kv.keyLocks[h.Sum32()%uint32(len(kv.keyLocks))]
+}
 
-	return &new
+// KVAny is a KV keyed by string storing untyped values, preserved for
+// callers that relied on the pre-generics interface{} API.
+type KVAny = KV[string, interface{}]
+
+// New creates a KV with the given default expiration and cleanup interval.
+// It is a thin shim over NewWithOptions for callers that don't need the
+// rest of the options surface.
+func New[K comparable, V any](defaultExpiration, cleanupInterval time.Duration) *KV[K, V] {
+	return NewWithOptions(
+		WithDefaultExpiration[K, V](defaultExpiration),
+		WithCleanupInterval[K, V](cleanupInterval),
+	)
 }
 
-func (kv *KV) OnEvicted(f func(string, interface{})) {
+func (kv *KV[K, V]) OnEvicted(f func(K, V)) {
 	kv.mu.Lock()
 	kv.onEvicted = f
 	kv.mu.Unlock()
 }
 
-func (kv *KV) OnDeleted(f func(string, interface{})) {
+func (kv *KV[K, V]) OnDeleted(f func(K, V)) {
 	kv.mu.Lock()
 	kv.onDeleted = f
 	kv.mu.Unlock()
 }
 
-func (kv *KV) Set(key string, value interface{}, exp time.Duration) {
+// KeyAndValue is one evicted entry as delivered to an OnEvictedBulk callback.
+type KeyAndValue[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// OnEvictedBulk registers a callback fired once per janitor pass with every
+// key evicted in that pass, instead of one goroutine per key. Use this
+// alongside or instead of OnEvicted when a bucket can hold many keys and
+// spawning a goroutine per key becomes a scheduling storm.
+func (kv *KV[K, V]) OnEvictedBulk(f func([]KeyAndValue[K, V])) {
+	kv.mu.Lock()
+	kv.onEvictedBulk = f
+	kv.mu.Unlock()
+}
+
+func (kv *KV[K, V]) Set(key K, value V, exp time.Duration) {
 
 	expiredAt := int64(0)
 
@@ -94,27 +132,26 @@ func (kv *KV) Set(key string, value interface{}, exp time.Duration) {
 		expiredAt = time.Now().Add(exp).UnixNano()
 	}
 
-	item := Item{
-		Key:        key,
-		Expiration: expiredAt,
+	item := Item[V]{
 		Object:     value,
+		Expiration: expiredAt,
 	}
 
 	if prior, found := kv.items.Load(key); !found {
 		kv.items.Store(key, item)
 
-		kv.metaAdd <- meta{
-			Key:        item.Key,
+		kv.metaAdd <- meta[K]{
+			Key:        key,
 			Expiration: item.Expiration,
 		}
 	} else {
-		priorItem := prior.(Item)
+		priorItem := prior.(Item[V])
 
 		kv.items.Store(key, item)
 
 		if item.Expiration != priorItem.Expiration {
-			kv.metaUpdate <- updateMeta{
-				meta: meta{
+			kv.metaUpdate <- updateMeta[K]{
+				meta: meta[K]{
 					Key:        key,
 					Expiration: expiredAt,
 				},
@@ -124,74 +161,104 @@ func (kv *KV) Set(key string, value interface{}, exp time.Duration) {
 	}
 }
 
-func (kv *KV) Update(key string, value interface{}) error {
+// UpdateMode controls what Update does to a key's expiration.
+type UpdateMode int
 
-	item := Item{}
-	if obj, ok := kv.items.Load(key); ok {
-		item = obj.(Item)
-	} else {
+const (
+	// UpdateKeepTTL leaves the key's current expiration untouched. This is
+	// the default when no mode is given.
+	UpdateKeepTTL UpdateMode = iota
+	// UpdateResetTTL re-derives the expiration from the KV's
+	// defaultExpiration, as if the key had just been Set again.
+	UpdateResetTTL
+)
+
+// Update replaces the value stored at key without touching its presence in
+// kv.exp unless mode is UpdateResetTTL. The zero value of mode,
+// UpdateKeepTTL, is almost always what callers want; passing no mode is
+// equivalent to passing it explicitly.
+func (kv *KV[K, V]) Update(key K, value V, mode ...UpdateMode) error {
+
+	obj, ok := kv.items.Load(key)
+	if !ok {
 		return errors.New("object doesn't exist")
 	}
+	item := obj.(Item[V])
+	priorExpiration := item.Expiration
+
+	m := UpdateKeepTTL
+	if len(mode) > 0 {
+		m = mode[0]
+	}
+
+	if m == UpdateResetTTL {
+		item.Expiration = time.Now().Add(kv.defaultExpiration).UnixNano()
+	}
 
 	item.Object = value
 	kv.items.Store(key, item)
 
-	kv.metaAdd <- meta{
-		Key:        item.Key,
-		Expiration: item.Expiration,
+	if m == UpdateResetTTL && item.Expiration != priorExpiration {
+		kv.metaUpdate <- updateMeta[K]{
+			meta: meta[K]{
+				Key:        key,
+				Expiration: item.Expiration,
+			},
+			PriorExpiration: priorExpiration,
+		}
 	}
 
 	return nil
 }
 
-func (kv *KV) Get(key string) (interface{}, bool) {
+func (kv *KV[K, V]) Get(key K) (V, bool) {
 
 	now := time.Now().UnixNano()
 
 	if obj, ok := kv.items.Load(key); ok {
-		val := obj.(Item)
-		if val.Expiration > now {
+		val := obj.(Item[V])
+		if notExpired(val.Expiration, now) {
 			return val.Object, true
 		}
 	}
 
-	return nil, false
+	var zero V
+	return zero, false
 }
 
-func (kv *KV) Delete(key string) error {
+func (kv *KV[K, V]) Delete(key K) error {
 	itm, err := kv.deleteInner(key)
 	if err != nil {
 		return err
 	}
-	kv.metaDelete <- meta{
-		Key:        itm.Key,
+	kv.metaDelete <- meta[K]{
+		Key:        key,
 		Expiration: itm.Expiration,
 	}
 	return nil
 }
 
-func (kv *KV) deleteInner(key string) (val Item, err error) {
+func (kv *KV[K, V]) deleteInner(key K) (val Item[V], err error) {
 	if obj, ok := kv.items.Load(key); ok {
-		val := obj.(Item)
+		val := obj.(Item[V])
 		if kv.onDeleted != nil {
 			go kv.onDeleted(key, val.Object)
 		}
 		kv.items.Delete(key)
-	} else {
-		return val, errors.New("key args not exist")
+		return val, nil
 	}
-	return val, err
+	return val, errors.New("key args not exist")
 }
 
-func (kv *KV) List() map[string]Item {
+func (kv *KV[K, V]) List() map[K]Item[V] {
 
 	now := time.Now().UnixNano()
-	m := make(map[string]Item)
+	m := make(map[K]Item[V])
 
 	kv.items.Range(func(key interface{}, value interface{}) bool {
-		item := value.(Item)
-		if item.Expiration > now {
-			m[item.Key] = item
+		item := value.(Item[V])
+		if notExpired(item.Expiration, now) {
+			m[key.(K)] = item
 		}
 		return true
 	})
@@ -199,24 +266,24 @@ func (kv *KV) List() map[string]Item {
 	return m
 }
 
-func (kv *KV) ListAll() map[string]Item {
-	m := make(map[string]Item)
+func (kv *KV[K, V]) ListAll() map[K]Item[V] {
+	m := make(map[K]Item[V])
 
 	kv.items.Range(func(key interface{}, value interface{}) bool {
-		item := value.(Item)
-		m[item.Key] = item
+		item := value.(Item[V])
+		m[key.(K)] = item
 		return true
 	})
 
 	return m
 }
 
-func (kv *KV) IsExist(key string) bool {
+func (kv *KV[K, V]) IsExist(key K) bool {
 	now := time.Now().UnixNano()
 
 	if obj, ok := kv.items.Load(key); ok {
-		val := obj.(Item)
-		if val.Expiration > now {
+		val := obj.(Item[V])
+		if notExpired(val.Expiration, now) {
 			return true
 		}
 	}
@@ -224,12 +291,12 @@ func (kv *KV) IsExist(key string) bool {
 	return false
 }
 
-func (kv *KV) IsExpired(key string) (bool, error) {
+func (kv *KV[K, V]) IsExpired(key K) (bool, error) {
 	now := time.Now().UnixNano()
 
 	if obj, ok := kv.items.Load(key); ok {
-		val := obj.(Item)
-		if val.Expiration > now {
+		val := obj.(Item[V])
+		if notExpired(val.Expiration, now) {
 			return false, nil
 		}
 		return true, nil
@@ -238,13 +305,13 @@ func (kv *KV) IsExpired(key string) (bool, error) {
 	return false, errors.New("requested key are not exist")
 }
 
-func (kv *KV) ItemCount() int {
+func (kv *KV[K, V]) ItemCount() int {
 	total := 0
 
 	now := time.Now().UnixNano()
 	kv.items.Range(func(key interface{}, value interface{}) bool {
-		item := value.(Item)
-		if item.Expiration > now {
+		item := value.(Item[V])
+		if notExpired(item.Expiration, now) {
 			total++
 		}
 		return true
@@ -253,7 +320,7 @@ func (kv *KV) ItemCount() int {
 	return total
 }
 
-func (kv *KV) ItemCountAll() int {
+func (kv *KV[K, V]) ItemCountAll() int {
 	total := 0
 
 	kv.items.Range(func(key interface{}, value interface{}) bool {
@@ -264,20 +331,35 @@ func (kv *KV) ItemCountAll() int {
 	return total
 }
 
-func (kv *KV) DeleteExpired() {
+func (kv *KV[K, V]) DeleteExpired() {
 
 	now := time.Now().UnixNano()
 	var expired int64
 
 	for expiration, keys := range kv.exp {
+		if expiration == 0 {
+			// The NoExpiration bucket holds keys that never expire.
+			continue
+		}
 		if expiration < now && len(keys) > 0 {
-			for k, _ := range keys {
+			batch := make([]KeyAndValue[K, V], 0, len(keys))
+
+			for k := range keys {
 				// dont send metadata updates for janitor
 				itm, err := kv.deleteInner(k)
+				if err != nil {
+					continue
+				}
 
-				if err == nil && kv.onEvicted != nil {
-					go kv.onEvicted(itm.Key, itm.Expiration)
+				if kv.onEvicted != nil {
+					go kv.onEvicted(k, itm.Object)
 				}
+
+				batch = append(batch, KeyAndValue[K, V]{Key: k, Value: itm.Object})
+			}
+
+			if kv.onEvictedBulk != nil && len(batch) > 0 {
+				go kv.onEvictedBulk(batch)
 			}
 
 			expired = expiration
@@ -288,35 +370,37 @@ func (kv *KV) DeleteExpired() {
 	delete(kv.exp, expired)
 }
 
-func (kv *KV) Flush() {
+func (kv *KV[K, V]) Flush() {
 	kv.items.Range(func(key interface{}, value interface{}) bool {
 		kv.items.Delete(key)
 		return true
 	})
 }
 
-func (kv *KV) runJanitor() {
+// addExpBucket records key under expiration in kv.exp, creating the bucket
+// if this is its first entry. Shared by the janitor's metaAdd handling and
+// NewWithOptions' initial-item seeding, which both need to place a key into
+// kv.exp without going through the metaAdd channel.
+func (kv *KV[K, V]) addExpBucket(expiration int64, key K) {
+	if curr, found := kv.exp[expiration]; found {
+		curr[key] = &struct{}{}
+	} else {
+		kv.exp[expiration] = map[K]*struct{}{key: {}}
+	}
+}
+
+func (kv *KV[K, V]) runJanitor() {
 	for {
 		select {
 		case lm := <-kv.metaAdd:
-			if curr, found := kv.exp[lm.Expiration]; found {
-				curr[lm.Key] = &struct{}{}
-				kv.exp[lm.Expiration] = curr
-			} else {
-				kv.exp[lm.Expiration] = map[string]*struct{}{lm.Key: {}}
-			}
+			kv.addExpBucket(lm.Expiration, lm.Key)
 		case mu := <-kv.metaUpdate:
 			if curr, found := kv.exp[mu.PriorExpiration]; found {
 				delete(curr, mu.Key)
 				kv.exp[mu.PriorExpiration] = curr
 			}
 
-			if curr, found := kv.exp[mu.Expiration]; found {
-				curr[mu.Key] = &struct{}{}
-				kv.exp[mu.Expiration] = curr
-			} else {
-				kv.exp[mu.Expiration] = map[string]*struct{}{mu.Key: {}}
-			}
+			kv.addExpBucket(mu.Expiration, mu.Key)
 		case md := <-kv.metaDelete:
 			if curr, found := kv.exp[md.Expiration]; found {
 				delete(curr, md.Key)
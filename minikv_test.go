@@ -1,12 +1,13 @@
 package minikv
 
 import (
+	"sync"
 	"testing"
 	"time"
 )
 
 func TestExistence(t *testing.T) {
-	kv := New(5*time.Second, NoExpiration)
+	kv := New[string, interface{}](5*time.Second, NoExpiration)
 
 	kv.Set("name", "mike", time.Second)
 	if kv.IsExist("name") != true {
@@ -21,7 +22,7 @@ func TestExistence(t *testing.T) {
 }
 
 func TestExistence_NoExpiration(t *testing.T) {
-	kv := New(5*time.Second, 1 * time.Second)
+	kv := New[string, interface{}](5*time.Second, 1*time.Second)
 
 	kv.Set("name", "mike", NoExpiration)
 	if kv.IsExist("name") != true {
@@ -45,7 +46,7 @@ func TestJanitor(t *testing.T) {
 
 	onEvictCalled := false
 
-	kv := New(200*time.Millisecond, 500*time.Millisecond)
+	kv := New[string, interface{}](200*time.Millisecond, 500*time.Millisecond)
 	kv.OnEvicted(func(key string, value interface{}) {
 		onEvictCalled = true
 	})
@@ -69,3 +70,41 @@ func TestJanitor(t *testing.T) {
 		t.Error("OnEvicted should be called")
 	}
 }
+
+func TestOnEvictedBulk(t *testing.T) {
+
+	var mu sync.Mutex
+	var evicted []KeyAndValue[string, interface{}]
+
+	kv := New[string, interface{}](200*time.Millisecond, 500*time.Millisecond)
+	kv.OnEvictedBulk(func(batch []KeyAndValue[string, interface{}]) {
+		mu.Lock()
+		evicted = append(evicted, batch...)
+		mu.Unlock()
+	})
+
+	kv.Set("name", "mike", DefaultExpiration)
+	kv.Set("age", 30, DefaultExpiration)
+
+	time.Sleep(900 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(evicted) != 2 {
+		t.Errorf("expected 2 evicted entries in one batch, got %d", len(evicted))
+	}
+}
+
+func TestTypedValues(t *testing.T) {
+	kv := New[string, int](time.Minute, time.Minute)
+
+	kv.Set("count", 42, DefaultExpiration)
+
+	v, ok := kv.Get("count")
+	if !ok {
+		t.Fatal("count should exist")
+	}
+	if v != 42 {
+		t.Errorf("expected 42, got %d", v)
+	}
+}
@@ -0,0 +1,97 @@
+package minikv
+
+import "time"
+
+const defaultChannelCapacity = 10
+
+// kvConfig collects everything an Option can set before a KV is built.
+type kvConfig[K comparable, V any] struct {
+	defaultExpiration time.Duration
+	cleanupInterval   time.Duration
+	channelCapacity   int
+	onEvicted         func(K, V)
+	onDeleted         func(K, V)
+	initialItems      map[K]Item[V]
+}
+
+// Option configures a KV built with NewWithOptions.
+type Option[K comparable, V any] func(*kvConfig[K, V])
+
+// WithDefaultExpiration sets the expiration used for Set calls passed
+// DefaultExpiration.
+func WithDefaultExpiration[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(cfg *kvConfig[K, V]) {
+		cfg.defaultExpiration = d
+	}
+}
+
+// WithCleanupInterval sets how often the janitor sweeps for expired items.
+func WithCleanupInterval[K comparable, V any](d time.Duration) Option[K, V] {
+	return func(cfg *kvConfig[K, V]) {
+		cfg.cleanupInterval = d
+	}
+}
+
+// WithChannelCapacity sets the buffer size of the metaAdd/metaUpdate/
+// metaDelete channels the janitor reads from. The default is 10.
+func WithChannelCapacity[K comparable, V any](n int) Option[K, V] {
+	return func(cfg *kvConfig[K, V]) {
+		cfg.channelCapacity = n
+	}
+}
+
+// WithOnEvicted registers the eviction callback up front, equivalent to
+// calling OnEvicted right after construction.
+func WithOnEvicted[K comparable, V any](f func(K, V)) Option[K, V] {
+	return func(cfg *kvConfig[K, V]) {
+		cfg.onEvicted = f
+	}
+}
+
+// WithOnDeleted registers the deletion callback up front, equivalent to
+// calling OnDeleted right after construction.
+func WithOnDeleted[K comparable, V any](f func(K, V)) Option[K, V] {
+	return func(cfg *kvConfig[K, V]) {
+		cfg.onDeleted = f
+	}
+}
+
+// WithInitialItems seeds the KV with items before the janitor starts, so
+// they're present from the first call with no race to populate them.
+func WithInitialItems[K comparable, V any](items map[K]Item[V]) Option[K, V] {
+	return func(cfg *kvConfig[K, V]) {
+		cfg.initialItems = items
+	}
+}
+
+// NewWithOptions builds a KV from the given options. It's the extensible
+// replacement for New, which is now a shim over this constructor.
+func NewWithOptions[K comparable, V any](opts ...Option[K, V]) *KV[K, V] {
+	cfg := &kvConfig[K, V]{
+		channelCapacity: defaultChannelCapacity,
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	kv := &KV[K, V]{
+		defaultExpiration: cfg.defaultExpiration,
+		cleanupInterval:   cfg.cleanupInterval,
+		onEvicted:         cfg.onEvicted,
+		onDeleted:         cfg.onDeleted,
+		metaAdd:           make(chan meta[K], cfg.channelCapacity),
+		metaUpdate:        make(chan updateMeta[K], cfg.channelCapacity),
+		metaDelete:        make(chan meta[K], cfg.channelCapacity),
+		exp:               make(map[int64]map[K]*struct{}),
+	}
+
+	for key, item := range cfg.initialItems {
+		kv.items.Store(key, item)
+		kv.addExpBucket(item.Expiration, key)
+	}
+
+	go kv.runJanitor()
+
+	return kv
+}
@@ -0,0 +1,84 @@
+package minikv
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewWithOptions(t *testing.T) {
+	kv := NewWithOptions(
+		WithDefaultExpiration[string, interface{}](time.Minute),
+		WithCleanupInterval[string, interface{}](time.Minute),
+		WithChannelCapacity[string, interface{}](64),
+		WithInitialItems(map[string]Item[interface{}]{
+			"seeded": {Object: "value", Expiration: time.Now().Add(time.Hour).UnixNano()},
+		}),
+	)
+
+	if v, ok := kv.Get("seeded"); !ok || v != "value" {
+		t.Errorf("expected seeded=value, got %v (ok=%v)", v, ok)
+	}
+
+	var mu sync.Mutex
+	evicted := false
+	kv2 := NewWithOptions(
+		WithDefaultExpiration[string, interface{}](200*time.Millisecond),
+		WithCleanupInterval[string, interface{}](500*time.Millisecond),
+		WithOnEvicted[string, interface{}](func(key string, value interface{}) {
+			mu.Lock()
+			evicted = true
+			mu.Unlock()
+		}),
+	)
+	kv2.Set("name", "mike", DefaultExpiration)
+
+	time.Sleep(900 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !evicted {
+		t.Error("WithOnEvicted callback should have fired")
+	}
+}
+
+func TestUpdateKeepsTTLByDefault(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+	kv.Set("name", "mike", 200*time.Millisecond)
+
+	before, _ := kv.items.Load("name")
+	expBefore := before.(Item[interface{}]).Expiration
+
+	if err := kv.Update("name", "mikey"); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	after, _ := kv.items.Load("name")
+	expAfter := after.(Item[interface{}]).Expiration
+
+	if expBefore != expAfter {
+		t.Errorf("Update should preserve Expiration by default: before=%d after=%d", expBefore, expAfter)
+	}
+	if v, _ := kv.Get("name"); v != "mikey" {
+		t.Errorf("expected name=mikey, got %v", v)
+	}
+}
+
+func TestUpdateResetTTL(t *testing.T) {
+	kv := New[string, interface{}](time.Hour, time.Minute)
+	kv.Set("name", "mike", 50*time.Millisecond)
+
+	before, _ := kv.items.Load("name")
+	expBefore := before.(Item[interface{}]).Expiration
+
+	if err := kv.Update("name", "mikey", UpdateResetTTL); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	after, _ := kv.items.Load("name")
+	expAfter := after.(Item[interface{}]).Expiration
+
+	if expAfter <= expBefore {
+		t.Errorf("UpdateResetTTL should have pushed the expiration out using the default TTL: before=%d after=%d", expBefore, expAfter)
+	}
+}
@@ -0,0 +1,125 @@
+package minikv
+
+import (
+	"encoding/gob"
+	"io"
+	"os"
+	"time"
+)
+
+// persistedItem is the on-disk/on-wire record written by Save and read back
+// by Load. It mirrors Item but carries the key alongside it, since gob
+// encodes one value at a time and has no notion of a map entry.
+type persistedItem[K comparable, V any] struct {
+	Key        K
+	Object     V
+	Expiration int64
+}
+
+// Register forwards to gob.Register, letting callers make custom struct
+// values stored in a KV persistable with Save/Load.
+func Register(value interface{}) {
+	gob.Register(value)
+}
+
+// NewFrom creates a KV and immediately restores it from r, as produced by a
+// prior Save. It fails if the stream cannot be decoded.
+func NewFrom[K comparable, V any](defaultExpiration, cleanupInterval time.Duration, r io.Reader) (*KV[K, V], error) {
+	kv := New[K, V](defaultExpiration, cleanupInterval)
+	if err := kv.Load(r); err != nil {
+		return nil, err
+	}
+	return kv, nil
+}
+
+// Save writes every non-expired item to w as a stream of gob-encoded
+// records, so the cache can later be restored with Load or NewFrom.
+func (kv *KV[K, V]) Save(w io.Writer) error {
+	now := time.Now().UnixNano()
+	enc := gob.NewEncoder(w)
+
+	var saveErr error
+	kv.items.Range(func(key interface{}, value interface{}) bool {
+		item := value.(Item[V])
+		if item.Expiration != 0 && item.Expiration <= now {
+			return true
+		}
+
+		saveErr = enc.Encode(persistedItem[K, V]{
+			Key:        key.(K),
+			Object:     item.Object,
+			Expiration: item.Expiration,
+		})
+		return saveErr == nil
+	})
+
+	return saveErr
+}
+
+// Load decodes a stream of records written by Save and inserts them, going
+// through the same metaAdd path as Set so the exp bucket map is rehydrated.
+// Items whose Expiration has already passed are skipped.
+func (kv *KV[K, V]) Load(r io.Reader) error {
+	dec := gob.NewDecoder(r)
+	now := time.Now().UnixNano()
+
+	for {
+		var rec persistedItem[K, V]
+		if err := dec.Decode(&rec); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		if rec.Expiration != 0 && rec.Expiration <= now {
+			continue
+		}
+
+		kv.items.Store(rec.Key, Item[V]{
+			Object:     rec.Object,
+			Expiration: rec.Expiration,
+		})
+
+		kv.metaAdd <- meta[K]{
+			Key:        rec.Key,
+			Expiration: rec.Expiration,
+		}
+	}
+}
+
+// SaveFile writes the cache to path, via a temp file plus rename so a reader
+// never observes a partially written snapshot.
+func (kv *KV[K, V]) SaveFile(path string) error {
+	tmp := path + ".tmp"
+
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	if err := kv.Save(f); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// LoadFile restores the cache from a snapshot previously written by
+// SaveFile.
+func (kv *KV[K, V]) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return kv.Load(f)
+}
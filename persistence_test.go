@@ -0,0 +1,76 @@
+package minikv
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSaveLoad(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+	kv.Set("name", "mike", time.Hour)
+	kv.Set("age", 30, time.Hour)
+
+	var buf bytes.Buffer
+	if err := kv.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	restored, err := NewFrom[string, interface{}](time.Minute, time.Minute, &buf)
+	if err != nil {
+		t.Fatalf("NewFrom: %v", err)
+	}
+
+	if v, ok := restored.Get("name"); !ok || v != "mike" {
+		t.Errorf("expected name=mike, got %v (ok=%v)", v, ok)
+	}
+	if v, ok := restored.Get("age"); !ok || v != 30 {
+		t.Errorf("expected age=30, got %v (ok=%v)", v, ok)
+	}
+}
+
+func TestSaveLoad_SkipsExpired(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+	kv.Set("gone", "bye", time.Millisecond)
+
+	time.Sleep(10 * time.Millisecond)
+
+	var buf bytes.Buffer
+	if err := kv.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	restored, err := NewFrom[string, interface{}](time.Minute, time.Minute, &buf)
+	if err != nil {
+		t.Fatalf("NewFrom: %v", err)
+	}
+
+	if restored.IsExist("gone") {
+		t.Error("expired item should not have been restored")
+	}
+}
+
+func TestSaveFileLoadFile(t *testing.T) {
+	kv := New[string, interface{}](time.Minute, time.Minute)
+	kv.Set("name", "mike", time.Hour)
+
+	path := filepath.Join(t.TempDir(), "snapshot.gob")
+	if err := kv.SaveFile(path); err != nil {
+		t.Fatalf("SaveFile: %v", err)
+	}
+
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Error("tmp file should not remain after a successful SaveFile")
+	}
+
+	restored := New[string, interface{}](time.Minute, time.Minute)
+	if err := restored.LoadFile(path); err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+
+	if v, ok := restored.Get("name"); !ok || v != "mike" {
+		t.Errorf("expected name=mike, got %v (ok=%v)", v, ok)
+	}
+}
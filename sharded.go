@@ -0,0 +1,100 @@
+package minikv
+
+import (
+	"hash/fnv"
+	"time"
+)
+
+// ShardedKV fans reads and writes for string keys across N independent KV
+// instances, each with its own janitor goroutine and metaAdd/metaUpdate/
+// metaDelete channels. This keeps the exp bucket map and channel traffic of
+// any one shard small, which matters under bursty concurrent writes where a
+// single KV's channels (capacity 10) become a bottleneck.
+type ShardedKV[V any] struct {
+	shards []*KV[string, V]
+}
+
+// NewSharded creates a ShardedKV with the given number of shards. Each shard
+// is an independently janitored KV[string, V].
+func NewSharded[V any](defaultExpiration, cleanupInterval time.Duration, shards int) *ShardedKV[V] {
+	if shards < 1 {
+		shards = 1
+	}
+
+	skv := &ShardedKV[V]{
+		shards: make([]*KV[string, V], shards),
+	}
+
+	for i := range skv.shards {
+		skv.shards[i] = New[string, V](defaultExpiration, cleanupInterval)
+	}
+
+	return skv
+}
+
+func (skv *ShardedKV[V]) shardFor(key string) *KV[string, V] {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return skv.shards[h.Sum32()%uint32(len(skv.shards))]
+}
+
+func (skv *ShardedKV[V]) Set(key string, value V, exp time.Duration) {
+	skv.shardFor(key).Set(key, value, exp)
+}
+
+func (skv *ShardedKV[V]) Get(key string) (V, bool) {
+	return skv.shardFor(key).Get(key)
+}
+
+func (skv *ShardedKV[V]) Update(key string, value V, mode ...UpdateMode) error {
+	return skv.shardFor(key).Update(key, value, mode...)
+}
+
+func (skv *ShardedKV[V]) Delete(key string) error {
+	return skv.shardFor(key).Delete(key)
+}
+
+func (skv *ShardedKV[V]) IsExist(key string) bool {
+	return skv.shardFor(key).IsExist(key)
+}
+
+// ItemCount returns the total number of non-expired items across all shards.
+func (skv *ShardedKV[V]) ItemCount() int {
+	total := 0
+	for _, shard := range skv.shards {
+		total += shard.ItemCount()
+	}
+	return total
+}
+
+// List merges the non-expired items of every shard into a single map.
+func (skv *ShardedKV[V]) List() map[string]Item[V] {
+	m := make(map[string]Item[V])
+	for _, shard := range skv.shards {
+		for k, v := range shard.List() {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// Flush clears every shard.
+func (skv *ShardedKV[V]) Flush() {
+	for _, shard := range skv.shards {
+		shard.Flush()
+	}
+}
+
+// OnEvicted registers f on every shard.
+func (skv *ShardedKV[V]) OnEvicted(f func(string, V)) {
+	for _, shard := range skv.shards {
+		shard.OnEvicted(f)
+	}
+}
+
+// OnDeleted registers f on every shard.
+func (skv *ShardedKV[V]) OnDeleted(f func(string, V)) {
+	for _, shard := range skv.shards {
+		shard.OnDeleted(f)
+	}
+}
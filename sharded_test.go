@@ -0,0 +1,47 @@
+package minikv
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestShardedKV(t *testing.T) {
+	skv := NewSharded[interface{}](time.Minute, time.Minute, 8)
+
+	for i := 0; i < 100; i++ {
+		skv.Set(fmt.Sprintf("key-%d", i), i, DefaultExpiration)
+	}
+
+	if skv.ItemCount() != 100 {
+		t.Errorf("expected 100 items across shards, got %d", skv.ItemCount())
+	}
+
+	v, ok := skv.Get("key-42")
+	if !ok || v != 42 {
+		t.Errorf("expected key-42=42, got %v (ok=%v)", v, ok)
+	}
+
+	if err := skv.Update("key-42", 43); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if v, _ := skv.Get("key-42"); v != 43 {
+		t.Errorf("expected key-42=43 after Update, got %v", v)
+	}
+
+	if err := skv.Delete("key-42"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if skv.IsExist("key-42") {
+		t.Error("key-42 should be gone after Delete")
+	}
+
+	if len(skv.List()) != 99 {
+		t.Errorf("expected 99 items in List(), got %d", len(skv.List()))
+	}
+
+	skv.Flush()
+	if skv.ItemCount() != 0 {
+		t.Errorf("expected 0 items after Flush, got %d", skv.ItemCount())
+	}
+}